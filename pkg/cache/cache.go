@@ -0,0 +1,25 @@
+// Package cache defines the Bugzilla client interface the operator's
+// controllers use, whose GetCachedBug serves single-bug lookups from a
+// cache so controllers that poll on a schedule don't hammer Bugzilla with
+// redundant lookups. The package holds no implementation of it.
+package cache
+
+import "github.com/eparis/bugzilla"
+
+// BugzillaClient is the subset of bugzilla.Client operations the operator's
+// controllers need, with single-bug lookups transparently cached.
+type BugzillaClient interface {
+	Search(query bugzilla.Query) ([]*bugzilla.Bug, error)
+
+	// GetCachedBug returns the bug for id, serving a cached copy unless
+	// lastChangeTime indicates it is stale.
+	GetCachedBug(id int, lastChangeTime string) (*bugzilla.Bug, bool, error)
+
+	UpdateBug(id int, update bugzilla.BugUpdate) error
+
+	// Comments returns all comments on the given bug, oldest first.
+	Comments(id int) ([]bugzilla.Comment, error)
+
+	// BugList returns the bugs in the named saved search shared by sharerID.
+	BugList(name, sharerID string) ([]bugzilla.Bug, error)
+}
@@ -0,0 +1,88 @@
+package bugutil
+
+import (
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/eparis/bugzilla"
+)
+
+// DefaultBotCommentKeywords is used whenever
+// OperatorConfig.BotCommentKeywords is empty. It matches the boilerplate
+// Bugzilla bots leave behind (PM Score bumps, sprint-planning nags, ...)
+// that should never count as real human activity on a bug.
+var DefaultBotCommentKeywords = []string{
+	`^PM[ -]?Score`,
+	`^UpcomingSprint`,
+	`^This bug will be evaluated during the next sprint`,
+}
+
+// LastHumanActivity walks comments in reverse and returns the creation
+// time of the most recent comment whose body does not match any of
+// botCommentPatterns, or the zero time.Time if every comment is bot
+// boilerplate (or there are no comments at all). Matching is regex-based
+// and case-insensitive; a comment only counts as bot boilerplate if every
+// non-blank line in it matches a pattern, so a bot's boilerplate quoted
+// inside an otherwise-human reply doesn't mask that reply.
+//
+// bugzilla.Comment.CreationTime is the raw Bugzilla timestamp string, so
+// it is parsed as RFC3339 before being returned.
+func LastHumanActivity(bug *bugzilla.Bug, comments []bugzilla.Comment, botCommentPatterns []string) (time.Time, error) {
+	patterns, err := compileBotCommentPatterns(botCommentPatterns)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	for i := len(comments) - 1; i >= 0; i-- {
+		if !isBotComment(comments[i].Text, patterns) {
+			return time.Parse(time.RFC3339, comments[i].CreationTime)
+		}
+	}
+
+	return time.Time{}, nil
+}
+
+// isBotComment reports whether every non-blank line of body matches one of
+// patterns. A single matching line is not enough: that would also discard
+// a human reply that merely quotes a bot's boilerplate on one line.
+func isBotComment(body string, patterns []*regexp.Regexp) bool {
+	sawLine := false
+	for _, line := range strings.Split(body, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		sawLine = true
+		if !matchesAny(line, patterns) {
+			return false
+		}
+	}
+	return sawLine
+}
+
+func matchesAny(line string, patterns []*regexp.Regexp) bool {
+	for _, p := range patterns {
+		if p.MatchString(line) {
+			return true
+		}
+	}
+	return false
+}
+
+func compileBotCommentPatterns(raw []string) ([]*regexp.Regexp, error) {
+	if len(raw) == 0 {
+		raw = DefaultBotCommentKeywords
+	}
+	patterns := make([]*regexp.Regexp, 0, len(raw))
+	for _, r := range raw {
+		// (?im): case-insensitive, and ^/$ anchor to each line rather than
+		// the whole comment body.
+		re, err := regexp.Compile("(?im)" + r)
+		if err != nil {
+			return nil, err
+		}
+		patterns = append(patterns, re)
+	}
+	return patterns, nil
+}
@@ -0,0 +1,89 @@
+package bugutil
+
+import (
+	"testing"
+	"time"
+
+	"github.com/eparis/bugzilla"
+)
+
+func TestLastHumanActivity(t *testing.T) {
+	t1 := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	t2 := time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC)
+	t3 := time.Date(2026, 1, 3, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		comments []bugzilla.Comment
+		want     time.Time
+	}{
+		{
+			name:     "no comments",
+			comments: nil,
+			want:     time.Time{},
+		},
+		{
+			name: "all bot boilerplate",
+			comments: []bugzilla.Comment{
+				{Text: "pm score bump", CreationTime: t1.Format(time.RFC3339)},
+				{Text: "UpcomingSprint reminder", CreationTime: t2.Format(time.RFC3339)},
+			},
+			want: time.Time{},
+		},
+		{
+			name: "bot comment followed by human reply",
+			comments: []bugzilla.Comment{
+				{Text: "PM Score bumped to 200", CreationTime: t1.Format(time.RFC3339)},
+				{Text: "I'll take a look this week", CreationTime: t2.Format(time.RFC3339)},
+			},
+			want: t2,
+		},
+		{
+			name: "human reply quoting a bot boilerplate line is not discarded",
+			comments: []bugzilla.Comment{
+				{Text: "PM Score bumped to 200", CreationTime: t1.Format(time.RFC3339)},
+				{Text: "> PM Score bumped to 200\nStill working on this one.", CreationTime: t3.Format(time.RFC3339)},
+			},
+			want: t3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := LastHumanActivity(&bugzilla.Bug{}, tt.comments, nil)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsBotComment(t *testing.T) {
+	patterns, err := compileBotCommentPatterns(nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		name string
+		body string
+		want bool
+	}{
+		{"single bot line", "PM Score bumped to 200", true},
+		{"blank lines around bot line are ignored", "\nPM Score bumped to 200\n\n", true},
+		{"human line quoting a bot line", "> PM Score bumped to 200\nStill working on this one.", false},
+		{"plain human comment", "Still working on this one.", false},
+		{"empty body", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isBotComment(tt.body, patterns); got != tt.want {
+				t.Errorf("isBotComment(%q) = %v, want %v", tt.body, got, tt.want)
+			}
+		})
+	}
+}
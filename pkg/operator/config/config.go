@@ -0,0 +1,65 @@
+// Package config defines the operator's YAML-loaded configuration.
+package config
+
+// OperatorConfig holds all the tunables for the bugzilla-operator's
+// controllers and reporters. It is loaded once at startup and handed to
+// every controller via ControllerContext.
+type OperatorConfig struct {
+	Credentials struct {
+		BugzillaAPIKey string `yaml:"bugzillaAPIKey"`
+		SlackToken     string `yaml:"slackToken"`
+	} `yaml:"credentials"`
+
+	Lists struct {
+		Closed BugzillaList `yaml:"closed"`
+	} `yaml:"lists"`
+
+	// UserMapping maps a Slack e-mail address to the Bugzilla e-mail
+	// address it should resolve to, for users whose two accounts differ.
+	UserMapping map[string]string `yaml:"userMapping"`
+
+	// PluginDir, if set, is scanned at startup for *.so reporter plugins
+	// built with `go build -buildmode=plugin` (see pkg/operator/reporter).
+	PluginDir string `yaml:"pluginDir,omitempty"`
+
+	// BotCommentKeywords are regexes matched against comment bodies to
+	// recognize Bugzilla bot boilerplate (PM Score, sprint-planning nags,
+	// ...) that should not count as human activity. Defaults to
+	// bugutil.DefaultBotCommentKeywords when empty.
+	BotCommentKeywords []string `yaml:"botCommentKeywords,omitempty"`
+
+	// ReactionTransitions maps a Slack reaction name (without colons, e.g.
+	// "eyes") to the Bugzilla status it should move a posted bug card to
+	// when someone reacts with it.
+	ReactionTransitions map[string]string `yaml:"reactionTransitions,omitempty"`
+
+	// Reporters lists which registered reporters to run, with optional
+	// per-reporter overrides. A reporter whose Name() isn't listed here
+	// does not run.
+	Reporters []ReporterConfig `yaml:"reporters"`
+}
+
+// ReporterConfig overrides the component list, schedule, or Slack channel
+// for a single registered reporter.
+type ReporterConfig struct {
+	// Name must match the registered reporter's Name().
+	Name string `yaml:"name"`
+
+	// Plugin, if set, is a *.so path (relative to PluginDir) to load
+	// before looking Name up in the registry.
+	Plugin string `yaml:"plugin,omitempty"`
+
+	// Component is honored only by the built-in "new" reporter, whose
+	// entries are registered and looked up under "Name:comp1,comp2".
+	// Configured cannot resolve an entry for any other reporter that sets it.
+	Component []string `yaml:"component,omitempty"`
+	Schedule  []string `yaml:"schedule,omitempty"`
+	Channel   string   `yaml:"channel,omitempty"`
+}
+
+// BugzillaList identifies a saved Bugzilla search by name and the ID of the
+// user that shared it.
+type BugzillaList struct {
+	Name     string `yaml:"name"`
+	SharerID string `yaml:"sharerID"`
+}
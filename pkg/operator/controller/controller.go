@@ -0,0 +1,68 @@
+// Package controller provides the shared context every reporter and
+// controller is constructed with.
+package controller
+
+import (
+	"context"
+	"time"
+
+	slackgo "github.com/slack-go/slack"
+
+	"github.com/openshift-eng/shodan/pkg/cache"
+	"github.com/openshift-eng/shodan/pkg/operator/state"
+	"github.com/openshift-eng/shodan/pkg/slack"
+)
+
+// BlockActionHandler handles a Slack interactive block_action.
+type BlockActionHandler func(ctx context.Context, message *slackgo.Container, user *slackgo.User, action *slackgo.BlockAction)
+
+// ControllerContext is injected into every reporter/controller constructor.
+// It centralizes access to the shared Bugzilla and Slack clients plus the
+// operator's small persistent key/value store, so individual controllers
+// don't each have to wire their own.
+type ControllerContext interface {
+	// NewBugzillaClient returns a (cached) Bugzilla client bound to ctx.
+	NewBugzillaClient(ctx context.Context) cache.BugzillaClient
+
+	// SlackClient returns the Slack channel client bound to ctx.
+	SlackClient(ctx context.Context) slack.ChannelClient
+
+	// GetPersistentValue/SetPersistentValue read and write a small bit of
+	// state the controller wants to survive a restart (e.g. the ID of the
+	// last bug it has seen).
+	GetPersistentValue(ctx context.Context, key string) (string, error)
+	SetPersistentValue(ctx context.Context, key, value string) error
+
+	// SubscribeBlockAction registers a handler for Slack interactive
+	// block_actions whose BlockID matches id.
+	SubscribeBlockAction(id string, handler BlockActionHandler) error
+
+	// SubscribeSlashCommand registers a handler for a slash command (e.g.
+	// "/bug"), invoked for every invocation regardless of channel.
+	SubscribeSlashCommand(command string, handler slack.SlashCommandHandler) error
+
+	// SubscribeMention registers a handler invoked whenever the bot is
+	// @mentioned in a channel it belongs to.
+	SubscribeMention(handler slack.MentionHandler) error
+
+	// SubscribeAppHomeOpened registers a handler invoked when a user opens
+	// the bot's Home tab.
+	SubscribeAppHomeOpened(handler slack.AppHomeOpenedHandler) error
+
+	// SubscribeReaction registers a handler invoked whenever someone
+	// reacts to a message the bot can see.
+	SubscribeReaction(handler slack.ReactionHandler) error
+
+	// GetBucket returns a handle onto the persistent BoltDB bucket at
+	// path, so controllers no longer have to keep their own state only in
+	// memory (see pkg/operator/state).
+	GetBucket(path ...string) (state.Bucket, error)
+
+	// IncrementStat atomically increments the named counter (e.g.
+	// state.TakesBucket/{user}) and returns its new value.
+	IncrementStat(bucket, key string) (int, error)
+
+	// RecordDuration folds d into the named running average (e.g.
+	// state.AssignDurationsBucket/{user}) and returns the updated stat.
+	RecordDuration(bucket, key string, d time.Duration) (state.DurationStat, error)
+}
@@ -0,0 +1,81 @@
+package reporter
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"plugin"
+
+	"k8s.io/klog"
+)
+
+var registry = map[string]Reporter{}
+
+// Register adds a reporter to the registry under its own Name(). Built-in
+// reporters are registered eagerly by main; reporters loaded via
+// LoadPlugin are registered the same way once their .so is opened.
+func Register(r Reporter) {
+	if _, exists := registry[r.Name()]; exists {
+		klog.Warningf("reporter %q already registered, overwriting", r.Name())
+	}
+	registry[r.Name()] = r
+}
+
+// Get returns the registered reporter for name, if any.
+func Get(name string) (Reporter, bool) {
+	r, ok := registry[name]
+	return r, ok
+}
+
+// All returns every registered reporter.
+func All() []Reporter {
+	out := make([]Reporter, 0, len(registry))
+	for _, r := range registry {
+		out = append(out, r)
+	}
+	return out
+}
+
+// LoadPlugin opens the *.so at path, built with `go build -buildmode=plugin`
+// and exporting a package-level `var Reporter reporter.Reporter`, following
+// the helperbot-style buildmode=plugin convention for out-of-tree reports.
+func LoadPlugin(path string) error {
+	p, err := plugin.Open(path)
+	if err != nil {
+		return fmt.Errorf("opening plugin %s: %w", path, err)
+	}
+	sym, err := p.Lookup("Reporter")
+	if err != nil {
+		return fmt.Errorf("plugin %s does not export Reporter: %w", path, err)
+	}
+	// Lookup of a package-level variable yields a pointer to it.
+	var r Reporter
+	switch v := sym.(type) {
+	case *Reporter:
+		r = *v
+	case Reporter:
+		r = v
+	}
+	if r == nil {
+		return fmt.Errorf("plugin %s exports Reporter with the wrong type", path)
+	}
+	Register(r)
+	return nil
+}
+
+// LoadPlugins loads every *.so file found directly under dir.
+func LoadPlugins(dir string) error {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+	for _, e := range entries {
+		if e.IsDir() || filepath.Ext(e.Name()) != ".so" {
+			continue
+		}
+		if err := LoadPlugin(filepath.Join(dir, e.Name())); err != nil {
+			return err
+		}
+	}
+	return nil
+}
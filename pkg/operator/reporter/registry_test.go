@@ -0,0 +1,85 @@
+package reporter
+
+import (
+	"context"
+	"testing"
+
+	"github.com/openshift-eng/shodan/pkg/cache"
+	"github.com/openshift-eng/shodan/pkg/slack"
+)
+
+type fakeReporter struct {
+	name string
+}
+
+func (f *fakeReporter) Name() string     { return f.name }
+func (f *fakeReporter) Schedule() []string { return []string{"@every 1h"} }
+func (f *fakeReporter) Sync(ctx context.Context, client cache.BugzillaClient, slackClient slack.ChannelClient) (Report, error) {
+	return Report{}, nil
+}
+
+func TestRegisterAndGet(t *testing.T) {
+	defer resetRegistry()
+
+	Register(&fakeReporter{name: "test-register"})
+
+	r, ok := Get("test-register")
+	if !ok {
+		t.Fatal("expected registered reporter to be found")
+	}
+	if r.Name() != "test-register" {
+		t.Errorf("got name %q, want %q", r.Name(), "test-register")
+	}
+
+	if _, ok := Get("does-not-exist"); ok {
+		t.Error("expected unregistered reporter to not be found")
+	}
+}
+
+func TestRegisterOverwrites(t *testing.T) {
+	defer resetRegistry()
+
+	first := &fakeReporter{name: "test-overwrite"}
+	second := &fakeReporter{name: "test-overwrite"}
+	Register(first)
+	Register(second)
+
+	r, ok := Get("test-overwrite")
+	if !ok {
+		t.Fatal("expected registered reporter to be found")
+	}
+	if r != second {
+		t.Error("expected later Register call to win")
+	}
+}
+
+func TestAll(t *testing.T) {
+	defer resetRegistry()
+
+	Register(&fakeReporter{name: "test-all-a"})
+	Register(&fakeReporter{name: "test-all-b"})
+
+	names := map[string]bool{}
+	for _, r := range All() {
+		names[r.Name()] = true
+	}
+	if !names["test-all-a"] || !names["test-all-b"] {
+		t.Errorf("expected All() to include both registered reporters, got %v", names)
+	}
+}
+
+func TestLoadPluginMissingFile(t *testing.T) {
+	if err := LoadPlugin("/no/such/plugin.so"); err == nil {
+		t.Error("expected an error opening a nonexistent plugin")
+	}
+}
+
+func TestLoadPluginsMissingDir(t *testing.T) {
+	if err := LoadPlugins("/no/such/directory"); err == nil {
+		t.Error("expected an error scanning a nonexistent plugin directory")
+	}
+}
+
+func resetRegistry() {
+	registry = map[string]Reporter{}
+}
@@ -0,0 +1,38 @@
+// Package reporter defines the pluggable reporting subsystem: a common
+// interface every report (closed, new, stale, severity-drift, CVE, ...)
+// implements, and a registry so reports can be wired up without the
+// operator's main package knowing about each one individually.
+package reporter
+
+import (
+	"context"
+
+	"github.com/openshift-eng/shodan/pkg/cache"
+	"github.com/openshift-eng/shodan/pkg/slack"
+)
+
+// Report is the rendered output of a single reporter run.
+type Report struct {
+	// Component is a short label identifying what the report covers,
+	// typically the Bugzilla component(s) or the reporter's own name.
+	Component string
+	Text      string
+}
+
+// Reporter is implemented by anything that can be scheduled to sync a
+// Bugzilla query against Slack. Built-in reports (closed, new) and
+// out-of-tree ones loaded via LoadPlugin all implement it, so the operator
+// can schedule and invoke them uniformly.
+type Reporter interface {
+	// Name identifies the reporter in logs and in the operator config's
+	// reporters: section.
+	Name() string
+
+	// Schedule returns the cron-style schedule(s) the reporter should run
+	// on, as understood by factory.Factory.ResyncSchedule.
+	Schedule() []string
+
+	// Sync runs one reporting pass and returns the report to post. A zero
+	// Report (empty Text) means there is nothing to say this time.
+	Sync(ctx context.Context, client cache.BugzillaClient, slackClient slack.ChannelClient) (Report, error)
+}
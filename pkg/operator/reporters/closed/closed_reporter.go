@@ -12,6 +12,12 @@ import (
 	"github.com/mfojtik/bugzilla-operator/pkg/operator/bugutil"
 	"github.com/mfojtik/bugzilla-operator/pkg/operator/config"
 	"github.com/mfojtik/bugzilla-operator/pkg/slack"
+
+	"github.com/openshift-eng/shodan/pkg/cache"
+	pluggablebugutil "github.com/openshift-eng/shodan/pkg/operator/bugutil"
+	pluggableconfig "github.com/openshift-eng/shodan/pkg/operator/config"
+	"github.com/openshift-eng/shodan/pkg/operator/reporter"
+	pluggableslack "github.com/openshift-eng/shodan/pkg/slack"
 )
 
 const bugzillaEndpoint = "https://bugzilla.redhat.com"
@@ -33,7 +39,7 @@ func NewClosedReporter(operatorConfig config.OperatorConfig, scheduleInformer fa
 
 func (c *BlockersReporter) sync(ctx context.Context, syncCtx factory.SyncContext) error {
 	client := c.newBugzillaClient()
-	report, err := Report(ctx, client, syncCtx.Recorder(), &c.config)
+	report, err := Report(ctx, client, syncCtx.Recorder(), c.config.Lists.Closed.Name, c.config.Lists.Closed.SharerID, nil)
 	if err != nil {
 		return err
 	}
@@ -49,8 +55,22 @@ func (c *BlockersReporter) sync(ctx context.Context, syncCtx factory.SyncContext
 	return nil
 }
 
-func Report(ctx context.Context, client bugzilla.Client, recorder events.Recorder, config *config.OperatorConfig) (string, error) {
-	closedBugs, err := client.BugList(config.Lists.Closed.Name, config.Lists.Closed.SharerID)
+// bugLister is the subset of capabilities Report needs: listing a saved
+// search and fetching a bug's comments. Both the raw bugzilla.Client the
+// scheduled sync() uses and cache.BugzillaClient the pluggable
+// reporterAdapter uses satisfy it, so Report doesn't need to pick one.
+type bugLister interface {
+	BugList(name, sharerID string) ([]bugzilla.Bug, error)
+	Comments(id int) ([]bugzilla.Comment, error)
+}
+
+// Report renders the closed-bug count for listName/listSharerID. The
+// caller passes botCommentKeywords directly rather than a whole
+// OperatorConfig because the legacy mfojtik config type sync() still runs
+// against has no such field; pass nil to fall back to
+// pluggablebugutil.DefaultBotCommentKeywords.
+func Report(ctx context.Context, client bugLister, recorder events.Recorder, listName, listSharerID string, botCommentKeywords []string) (string, error) {
+	closedBugs, err := client.BugList(listName, listSharerID)
 	if err != nil {
 		recorder.Warningf("BuglistFailed", err.Error())
 		return "", err
@@ -65,7 +85,15 @@ func Report(ctx context.Context, client bugzilla.Client, recorder events.Recorde
 	for resolution, bugs := range resolutionMap {
 		ids := []string{}
 		for _, b := range bugs {
-			ids = append(ids, fmt.Sprintf("<https://bugzilla.redhat.com/show_bug.cgi?id=%d|#%d>", b.ID, b.ID))
+			id := fmt.Sprintf("<https://bugzilla.redhat.com/show_bug.cgi?id=%d|#%d>", b.ID, b.ID)
+			if comments, err := client.Comments(b.ID); err != nil {
+				recorder.Warningf("CommentsFailed", "Failed to get comments for bug %d: %v", b.ID, err)
+			} else if lastHuman, err := pluggablebugutil.LastHumanActivity(&b, comments, botCommentKeywords); err != nil {
+				recorder.Warningf("BotCommentKeywordsFailed", "Failed to evaluate bot comment keywords for bug %d: %v", b.ID, err)
+			} else if lastHuman.IsZero() {
+				id += " _(closed without human comment)_"
+			}
+			ids = append(ids, id)
 		}
 		p := "bugs"
 		if len(bugs) == 1 {
@@ -81,3 +109,25 @@ func Report(ctx context.Context, client bugzilla.Client, recorder events.Recorde
 	report := fmt.Sprintf("*%s Closed in the last 24h*:\n%s\n", bugutil.BugCountPlural(len(closedBugs), true), strings.Join(message, "\n"))
 	return report, nil
 }
+
+// reporterAdapter makes Report pluggable via reporter.Register.
+type reporterAdapter struct {
+	config   pluggableconfig.OperatorConfig
+	recorder events.Recorder
+}
+
+func NewReporter(operatorConfig pluggableconfig.OperatorConfig, recorder events.Recorder) reporter.Reporter {
+	return &reporterAdapter{config: operatorConfig, recorder: recorder}
+}
+
+func (a *reporterAdapter) Name() string { return "closed" }
+
+func (a *reporterAdapter) Schedule() []string { return []string{"@every 24h"} }
+
+func (a *reporterAdapter) Sync(ctx context.Context, client cache.BugzillaClient, slackClient pluggableslack.ChannelClient) (reporter.Report, error) {
+	text, err := Report(ctx, client, a.recorder, a.config.Lists.Closed.Name, a.config.Lists.Closed.SharerID, a.config.BotCommentKeywords)
+	if err != nil || text == "" {
+		return reporter.Report{}, err
+	}
+	return reporter.Report{Component: "closed", Text: text}, nil
+}
@@ -0,0 +1,149 @@
+// Package leaderboard reports on weekly triage activity accumulated by
+// other reporters in state.Store, modeled on the Advent-of-Code
+// leaderboard pattern where per-user stats accumulate in named buckets and
+// are periodically rendered.
+package leaderboard
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/openshift-eng/shodan/pkg/cache"
+	"github.com/openshift-eng/shodan/pkg/operator/reporter"
+	"github.com/openshift-eng/shodan/pkg/operator/state"
+	"github.com/openshift-eng/shodan/pkg/slack"
+)
+
+// Reporter posts the top triagers of the last week: who clicked Take the
+// most (state.TakesBucket) and whose bugs moved from NEW to ASSIGNED
+// fastest on average (state.AssignDurationsBucket), both kept up to date
+// by new.NewBugReporter.
+type Reporter struct {
+	store *state.Store
+}
+
+func NewReporter(store *state.Store) reporter.Reporter {
+	return &Reporter{store: store}
+}
+
+func (r *Reporter) Name() string { return "leaderboard" }
+
+func (r *Reporter) Schedule() []string { return []string{"@weekly"} }
+
+func (r *Reporter) Sync(ctx context.Context, client cache.BugzillaClient, slackClient slack.ChannelClient) (reporter.Report, error) {
+	// Sync runs @weekly, at midnight between Saturday and Sunday. Stepping
+	// back one day lands on that Saturday, so the report covers the ISO
+	// week (Monday to Sunday) it belongs to, up to the end of Saturday.
+	// Activity recorded on the following Sunday goes into that same week's
+	// buckets after the report and does not appear in any report.
+	lastWeek := time.Now().AddDate(0, 0, -1)
+
+	takeLines, err := r.takeLines(lastWeek)
+	if err != nil {
+		return reporter.Report{}, err
+	}
+	durationLines, err := r.durationLines(lastWeek)
+	if err != nil {
+		return reporter.Report{}, err
+	}
+	if takeLines == nil && durationLines == nil {
+		return reporter.Report{}, nil
+	}
+
+	var sections []string
+	if takeLines != nil {
+		sections = append(sections, strings.Join(takeLines, "\n"))
+	}
+	if durationLines != nil {
+		sections = append(sections, strings.Join(durationLines, "\n"))
+	}
+
+	return reporter.Report{Component: "leaderboard", Text: strings.Join(sections, "\n\n")}, nil
+}
+
+// takeLines ranks users by how many bugs they clicked Take on during the
+// week containing week, descending, or returns nil if nobody took one
+// that week.
+func (r *Reporter) takeLines(week time.Time) ([]string, error) {
+	bucket, err := r.store.GetBucket(state.WeeklyBucket(state.TakesBucket, week))
+	if err != nil {
+		return nil, err
+	}
+
+	type userStat struct {
+		user  string
+		takes int
+	}
+	var stats []userStat
+	if err := bucket.ForEach(func(user string, value []byte) error {
+		n, err := strconv.Atoi(string(value))
+		if err != nil {
+			return nil // skip a corrupt counter rather than failing the whole report
+		}
+		stats = append(stats, userStat{user, n})
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	if len(stats) == 0 {
+		return nil, nil
+	}
+
+	sort.Slice(stats, func(i, j int) bool { return stats[i].takes > stats[j].takes })
+
+	lines := []string{"*Top triagers this week:*"}
+	for i, s := range stats {
+		if i >= 10 {
+			lines = append(lines, fmt.Sprintf(" ... and %d more", len(stats)-10))
+			break
+		}
+		lines = append(lines, fmt.Sprintf("%d. %s – %d bug(s) taken", i+1, s.user, s.takes))
+	}
+	return lines, nil
+}
+
+// durationLines ranks users by their average NEW→ASSIGNED turnaround
+// during the week containing week, ascending (fastest first), or returns
+// nil if nothing was recorded that week.
+func (r *Reporter) durationLines(week time.Time) ([]string, error) {
+	bucket, err := r.store.GetBucket(state.WeeklyBucket(state.AssignDurationsBucket, week))
+	if err != nil {
+		return nil, err
+	}
+
+	type userStat struct {
+		user string
+		avg  time.Duration
+	}
+	var stats []userStat
+	if err := bucket.ForEach(func(user string, value []byte) error {
+		var stat state.DurationStat
+		if err := json.Unmarshal(value, &stat); err != nil {
+			return nil // skip a corrupt stat rather than failing the whole report
+		}
+		stats = append(stats, userStat{user, stat.Average()})
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	if len(stats) == 0 {
+		return nil, nil
+	}
+
+	sort.Slice(stats, func(i, j int) bool { return stats[i].avg < stats[j].avg })
+
+	lines := []string{"*Fastest NEW → ASSIGNED this week:*"}
+	for i, s := range stats {
+		if i >= 10 {
+			lines = append(lines, fmt.Sprintf(" ... and %d more", len(stats)-10))
+			break
+		}
+		lines = append(lines, fmt.Sprintf("%d. %s – %s avg", i+1, s.user, s.avg.Round(time.Minute)))
+	}
+	return lines, nil
+}
@@ -0,0 +1,168 @@
+package leaderboard
+
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/openshift-eng/shodan/pkg/operator/state"
+)
+
+func openTestStore(t *testing.T) *state.Store {
+	t.Helper()
+	s, err := state.Open(filepath.Join(t.TempDir(), "state.db"))
+	if err != nil {
+		t.Fatalf("Open: %v", err)
+	}
+	t.Cleanup(func() { s.Close() })
+	return s
+}
+
+func TestTakeLines(t *testing.T) {
+	week := time.Date(2026, 1, 19, 0, 0, 0, 0, time.UTC)
+
+	t.Run("nobody took a bug that week", func(t *testing.T) {
+		r := &Reporter{store: openTestStore(t)}
+		lines, err := r.takeLines(week)
+		if err != nil {
+			t.Fatalf("takeLines: %v", err)
+		}
+		if lines != nil {
+			t.Errorf("got %v, want nil", lines)
+		}
+	})
+
+	t.Run("ranks descending by takes", func(t *testing.T) {
+		s := openTestStore(t)
+		r := &Reporter{store: s}
+		for _, take := range []struct {
+			user  string
+			count int
+		}{
+			{"alice@example.com", 3},
+			{"bob@example.com", 5},
+			{"carol@example.com", 1},
+		} {
+			for i := 0; i < take.count; i++ {
+				if _, err := s.IncrementStat(state.WeeklyBucket(state.TakesBucket, week), take.user); err != nil {
+					t.Fatalf("IncrementStat: %v", err)
+				}
+			}
+		}
+
+		lines, err := r.takeLines(week)
+		if err != nil {
+			t.Fatalf("takeLines: %v", err)
+		}
+		want := []string{
+			"*Top triagers this week:*",
+			"1. bob@example.com – 5 bug(s) taken",
+			"2. alice@example.com – 3 bug(s) taken",
+			"3. carol@example.com – 1 bug(s) taken",
+		}
+		if len(lines) != len(want) {
+			t.Fatalf("got %v, want %v", lines, want)
+		}
+		for i := range want {
+			if lines[i] != want[i] {
+				t.Errorf("line %d = %q, want %q", i, lines[i], want[i])
+			}
+		}
+	})
+
+	t.Run("activity in a different week doesn't count", func(t *testing.T) {
+		s := openTestStore(t)
+		r := &Reporter{store: s}
+		otherWeek := week.AddDate(0, 0, 7)
+		if _, err := s.IncrementStat(state.WeeklyBucket(state.TakesBucket, otherWeek), "alice@example.com"); err != nil {
+			t.Fatalf("IncrementStat: %v", err)
+		}
+
+		lines, err := r.takeLines(week)
+		if err != nil {
+			t.Fatalf("takeLines: %v", err)
+		}
+		if lines != nil {
+			t.Errorf("got %v, want nil", lines)
+		}
+	})
+
+	t.Run("truncates past the top 10", func(t *testing.T) {
+		s := openTestStore(t)
+		r := &Reporter{store: s}
+		for i := 0; i < 11; i++ {
+			user := fmt.Sprintf("user%02d@example.com", i)
+			if _, err := s.IncrementStat(state.WeeklyBucket(state.TakesBucket, week), user); err != nil {
+				t.Fatalf("IncrementStat: %v", err)
+			}
+		}
+
+		lines, err := r.takeLines(week)
+		if err != nil {
+			t.Fatalf("takeLines: %v", err)
+		}
+		if len(lines) != 12 { // header + 10 ranked + "and N more"
+			t.Fatalf("got %d lines, want 12: %v", len(lines), lines)
+		}
+		if got, want := lines[len(lines)-1], " ... and 1 more"; got != want {
+			t.Errorf("last line = %q, want %q", got, want)
+		}
+	})
+}
+
+func TestDurationLines(t *testing.T) {
+	week := time.Date(2026, 1, 19, 0, 0, 0, 0, time.UTC)
+
+	t.Run("nothing recorded that week", func(t *testing.T) {
+		r := &Reporter{store: openTestStore(t)}
+		lines, err := r.durationLines(week)
+		if err != nil {
+			t.Fatalf("durationLines: %v", err)
+		}
+		if lines != nil {
+			t.Errorf("got %v, want nil", lines)
+		}
+	})
+
+	t.Run("ranks ascending by average turnaround", func(t *testing.T) {
+		s := openTestStore(t)
+		r := &Reporter{store: s}
+		bucket := state.WeeklyBucket(state.AssignDurationsBucket, week)
+		if _, err := s.RecordDuration(bucket, "slow@example.com", 3*time.Hour); err != nil {
+			t.Fatalf("RecordDuration: %v", err)
+		}
+		if _, err := s.RecordDuration(bucket, "fast@example.com", 10*time.Minute); err != nil {
+			t.Fatalf("RecordDuration: %v", err)
+		}
+
+		lines, err := r.durationLines(week)
+		if err != nil {
+			t.Fatalf("durationLines: %v", err)
+		}
+		want := []string{
+			"*Fastest NEW → ASSIGNED this week:*",
+			"1. fast@example.com – 10m0s avg",
+			"2. slow@example.com – 3h0m0s avg",
+		}
+		if len(lines) != len(want) {
+			t.Fatalf("got %v, want %v", lines, want)
+		}
+		for i := range want {
+			if lines[i] != want[i] {
+				t.Errorf("line %d = %q, want %q", i, lines[i], want[i])
+			}
+		}
+	})
+}
+
+func TestSyncNilSectionsWhenNoActivity(t *testing.T) {
+	r := &Reporter{store: openTestStore(t)}
+	report, err := r.Sync(nil, nil, nil)
+	if err != nil {
+		t.Fatalf("Sync: %v", err)
+	}
+	if report.Text != "" {
+		t.Errorf("got report %+v, want an empty report when nobody did anything", report)
+	}
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/openshift/library-go/pkg/controller/factory"
 	"github.com/openshift/library-go/pkg/operator/events"
 	slackgo "github.com/slack-go/slack"
+	"github.com/slack-go/slack/slackevents"
 	errorutil "k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/klog"
 
@@ -20,6 +21,8 @@ import (
 	"github.com/openshift-eng/shodan/pkg/operator/bugutil"
 	"github.com/openshift-eng/shodan/pkg/operator/config"
 	"github.com/openshift-eng/shodan/pkg/operator/controller"
+	"github.com/openshift-eng/shodan/pkg/operator/reporter"
+	"github.com/openshift-eng/shodan/pkg/operator/state"
 	"github.com/openshift-eng/shodan/pkg/slack"
 )
 
@@ -57,12 +60,84 @@ func NewNewBugReporter(ctx controller.ControllerContext, components, schedule []
 	if err := ctx.SubscribeBlockAction(c.takeBlockerID, c.takeClicked); err != nil {
 		klog.Warning(err)
 	}
+	if err := ctx.SubscribeSlashCommand("/bug", c.handleBugCommand); err != nil {
+		klog.Warning(err)
+	}
+	if err := ctx.SubscribeMention(c.handleMention); err != nil {
+		klog.Warning(err)
+	}
+	if err := ctx.SubscribeReaction(c.handleReaction); err != nil {
+		klog.Warning(err)
+	}
 
+	c.loadPersistedMessages()
 	go c.updateMessages()
 
 	return factory.New().WithSync(c.sync).ResyncSchedule(schedule...).ToController("NewBugReporter", recorder)
 }
 
+// messagesBucket is the BoltDB bucket this reporter's posted-message
+// records live in, keyed by Slack message timestamp.
+func (c *NewBugReporter) messagesBucket() (state.Bucket, error) {
+	return c.GetBucket("messages-" + strings.Join(c.components, "-"))
+}
+
+// loadPersistedMessages recovers the set of messages being watched from
+// BoltDB, so a restart doesn't forget about bugs that are still NEW.
+func (c *NewBugReporter) loadPersistedMessages() {
+	bucket, err := c.messagesBucket()
+	if err != nil {
+		klog.Warningf("failed to open messages bucket: %v", err)
+		return
+	}
+
+	c.messagesLock.Lock()
+	defer c.messagesLock.Unlock()
+
+	if err := bucket.ForEach(func(ts string, value []byte) error {
+		var record state.MessageRecord
+		if err := json.Unmarshal(value, &record); err != nil {
+			klog.Warningf("skipping corrupt message record %s: %v", ts, err)
+			return nil
+		}
+		c.messagesToWatchAndUpdate = append(c.messagesToWatchAndUpdate, message{record.CreatedAt, record.BugID, record.ChannelID, ts})
+		return nil
+	}); err != nil {
+		klog.Warningf("failed to load persisted messages: %v", err)
+	}
+}
+
+// persistMessage records a newly posted bug card so it survives a restart.
+func (c *NewBugReporter) persistMessage(m message, component string) {
+	bucket, err := c.messagesBucket()
+	if err != nil {
+		klog.Warningf("failed to open messages bucket: %v", err)
+		return
+	}
+	value, err := json.Marshal(state.MessageRecord{BugID: m.ID, ChannelID: m.channelID, CreatedAt: m.createdAt, Component: component})
+	if err != nil {
+		klog.Warningf("failed to marshal message record: %v", err)
+		return
+	}
+	if err := bucket.Put(m.ts, value); err != nil {
+		klog.Warningf("failed to persist message record: %v", err)
+	}
+}
+
+// forgetMessage removes a dropped message's persisted record, so a restart
+// doesn't reload (and re-post an update for) a bug that's already aged out
+// or been resolved.
+func (c *NewBugReporter) forgetMessage(m message) {
+	bucket, err := c.messagesBucket()
+	if err != nil {
+		klog.Warningf("failed to open messages bucket: %v", err)
+		return
+	}
+	if err := bucket.Delete(m.ts); err != nil {
+		klog.Warningf("failed to delete persisted message record: %v", err)
+	}
+}
+
 func (c *NewBugReporter) updateMessages() {
 	for {
 		func() {
@@ -81,6 +156,7 @@ func (c *NewBugReporter) updateMessages() {
 			notTooOldMessages := make([]message, 0, len(c.messagesToWatchAndUpdate))
 			for _, m := range c.messagesToWatchAndUpdate {
 				if m.createdAt.Before(time.Now().Add(-time.Hour * 24 * 30)) {
+					c.forgetMessage(m)
 					continue
 				}
 				notTooOldMessages = append(notTooOldMessages, m)
@@ -102,17 +178,18 @@ func (c *NewBugReporter) updateMessages() {
 					continue
 				}
 
+				if b.AssignedTo != "" {
+					if _, err := c.RecordDuration(state.WeeklyBucket(state.AssignDurationsBucket, time.Now()), b.AssignedTo, time.Since(m.createdAt)); err != nil {
+						klog.Warningf("Failed to record NEW->ASSIGNED duration for %s: %v", b.AssignedTo, err)
+					}
+				}
+
 				text := fmt.Sprintf("%s – assigned to %s", bugutil.FormatBugMessage(*b), b.AssignedTo)
-				klog.Infof("Updating message to: %v", text)
-				if _, _, _, err := c.slackGoClient.UpdateMessage(
-					m.channelID,
-					m.ts,
-					slackgo.MsgOptionBlocks(
-						slackgo.NewSectionBlock(slackgo.NewTextBlockObject("mrkdwn", text, false, false), nil, nil),
-					),
-				); err != nil {
-					klog.Errorf("Failed to update message: %v", err)
+				klog.Infof("Posting threaded update: %v", text)
+				if err := c.postThreadedUpdate(context.Background(), m, text); err != nil {
+					klog.Errorf("Failed to post threaded update: %v", err)
 				}
+				c.forgetMessage(m)
 			}
 			c.messagesToWatchAndUpdate = messagesToWatch
 
@@ -121,6 +198,19 @@ func (c *NewBugReporter) updateMessages() {
 	}
 }
 
+// postThreadedUpdate posts text as a threaded reply under the originally
+// posted bug card, preserving a visible audit trail instead of overwriting
+// the card in place.
+func (c *NewBugReporter) postThreadedUpdate(ctx context.Context, m message, text string) error {
+	_, _, err := c.SlackClient(ctx).PostMessageChannel(
+		slackgo.MsgOptionTS(m.ts),
+		slackgo.MsgOptionBlocks(
+			slackgo.NewSectionBlock(slackgo.NewTextBlockObject("mrkdwn", text, false, false), nil, nil),
+		),
+	)
+	return err
+}
+
 func (c *NewBugReporter) sync(ctx context.Context, syncCtx factory.SyncContext) (err error) {
 	client := c.NewBugzillaClient(ctx)
 	slackClient := c.SlackClient(ctx)
@@ -159,17 +249,31 @@ func (c *NewBugReporter) sync(ctx context.Context, syncCtx factory.SyncContext)
 			lastID = b.ID
 		}
 
+		text := bugutil.FormatBugMessage(*b)
+		if comments, err := client.Comments(b.ID); err != nil {
+			klog.Warningf("failed to get comments for bug %d: %v", b.ID, err)
+		} else if lastHuman, err := bugutil.LastHumanActivity(b, comments, c.config.BotCommentKeywords); err != nil {
+			klog.Warningf("failed to evaluate bot comment keywords for bug %d: %v", b.ID, err)
+		} else if lastHuman.IsZero() {
+			// A bug bumped only by PM-Score/sprint bots still needs a human
+			// to look at it, so call that out rather than letting it blend
+			// in with bugs that already got real attention.
+			text += "\n:robot_face: _no human comments yet_"
+		}
+
 		value, _ := json.Marshal(TakeValue{b.ID, b.AssignedTo})
 		ch, ts, err := slackClient.PostMessageChannel(
 			slackgo.MsgOptionBlocks(
-				slackgo.NewSectionBlock(slackgo.NewTextBlockObject("mrkdwn", bugutil.FormatBugMessage(*b), false, false), nil, nil),
+				slackgo.NewSectionBlock(slackgo.NewTextBlockObject("mrkdwn", text, false, false), nil, nil),
 				slackgo.NewActionBlock(c.takeBlockerID,
 					slackgo.NewButtonBlockElement("btn", string(value), slackgo.NewTextBlockObject("plain_text", "Take this Bug", true, false)).WithStyle(slackgo.StylePrimary),
 				),
 			),
 		)
 		if err == nil {
-			c.messagesToWatchAndUpdate = append(c.messagesToWatchAndUpdate, message{time.Now(), b.ID, ch, ts})
+			m := message{time.Now(), b.ID, ch, ts}
+			c.messagesToWatchAndUpdate = append(c.messagesToWatchAndUpdate, m)
+			c.persistMessage(m, strings.Join(b.Component, ","))
 		}
 	}
 
@@ -238,6 +342,10 @@ func (c *NewBugReporter) takeClicked(ctx context.Context, message *slackgo.Conta
 			return
 		}
 
+		if _, err := c.IncrementStat(state.WeeklyBucket(state.TakesBucket, time.Now()), bzEmail); err != nil {
+			klog.Warningf("Failed to record take for leaderboard: %v", err)
+		}
+
 		b, _, err = client.GetCachedBug(value.ID, "")
 		if err != nil {
 			slackClient.MessageChannel(fmt.Sprintf("%s took: %s", bzEmail, bugutil.FormatBugMessage(*b)))
@@ -246,19 +354,181 @@ func (c *NewBugReporter) takeClicked(ctx context.Context, message *slackgo.Conta
 		}
 
 		text := fmt.Sprintf("%s – assigned to %s", bugutil.FormatBugMessage(*b), bzEmail)
-		klog.Infof("Updating message to: %v", text)
-		if _, _, _, err := c.slackGoClient.UpdateMessage(
-			message.ChannelID,
-			message.MessageTs,
+		klog.Infof("Posting threaded update: %v", text)
+		if _, _, err := slackClient.PostMessageChannel(
+			slackgo.MsgOptionTS(message.MessageTs),
 			slackgo.MsgOptionBlocks(
 				slackgo.NewSectionBlock(slackgo.NewTextBlockObject("mrkdwn", text, false, false), nil, nil),
 			),
 		); err != nil {
 			slackClient.MessageChannel(fmt.Sprintf("%s took: %s", bzEmail, bugutil.FormatBugMessage(*b)))
-			klog.Errorf("Failed to update message: %v", err)
+			klog.Errorf("Failed to post threaded update: %v", err)
 		}
 	}()
 }
+
+// handleBugCommand implements the "/bug <verb> <args>" slash command, so
+// engineers can act on a bug from any channel instead of only by clicking
+// "Take this Bug" on a posted card:
+//
+//	/bug take <id>
+//	/bug assign <id> @user
+//	/bug close <id> <resolution>
+func (c *NewBugReporter) handleBugCommand(ctx context.Context, cmd slackgo.SlashCommand) error {
+	fields := strings.Fields(cmd.Text)
+	if len(fields) < 2 {
+		return c.replyEphemeral(ctx, cmd.UserID, "usage: /bug <take|assign|close> <id> [args]")
+	}
+
+	verb, id := fields[0], fields[1]
+	bugID, err := strconv.Atoi(strings.TrimPrefix(id, "#"))
+	if err != nil {
+		return c.replyEphemeral(ctx, cmd.UserID, fmt.Sprintf("%q is not a bug ID", id))
+	}
+
+	client := c.NewBugzillaClient(ctx)
+	switch verb {
+	case "take":
+		bzEmail, err := c.resolveBugzillaEmail(cmd.UserID)
+		if err != nil {
+			return c.replyEphemeral(ctx, cmd.UserID, fmt.Sprintf("failed to resolve your Slack profile: %v", err))
+		}
+		return c.assignBug(ctx, client, cmd.UserID, bugID, bzEmail)
+	case "assign":
+		if len(fields) < 3 {
+			return c.replyEphemeral(ctx, cmd.UserID, "usage: /bug assign <id> @user")
+		}
+		assigneeID := parseMentionUserID(fields[2])
+		bzEmail, err := c.resolveBugzillaEmail(assigneeID)
+		if err != nil {
+			return c.replyEphemeral(ctx, cmd.UserID, fmt.Sprintf("failed to resolve %s's Slack profile: %v", fields[2], err))
+		}
+		return c.assignBug(ctx, client, cmd.UserID, bugID, bzEmail)
+	case "close":
+		if len(fields) < 3 {
+			return c.replyEphemeral(ctx, cmd.UserID, "usage: /bug close <id> <resolution>")
+		}
+		resolution := strings.Join(fields[2:], " ")
+		if err := client.UpdateBug(bugID, bugzilla.BugUpdate{Status: "CLOSED", Resolution: resolution}); err != nil {
+			return c.replyEphemeral(ctx, cmd.UserID, fmt.Sprintf("failed to close #%d: %v", bugID, err))
+		}
+		return c.replyEphemeral(ctx, cmd.UserID, fmt.Sprintf("closed #%d as %s", bugID, resolution))
+	default:
+		return c.replyEphemeral(ctx, cmd.UserID, fmt.Sprintf("unknown /bug verb %q", verb))
+	}
+}
+
+// resolveBugzillaEmail looks up the Bugzilla e-mail address for a Slack
+// user ID, the same way takeClicked and handleReaction do, rather than
+// treating the Slack ID itself as an e-mail.
+func (c *NewBugReporter) resolveBugzillaEmail(userID string) (string, error) {
+	profile, err := c.slackGoClient.GetUserProfile(userID, false)
+	if err != nil {
+		return "", err
+	}
+	return slack.SlackEmailToBugzilla(&c.config, profile.Email), nil
+}
+
+// parseMentionUserID extracts the user ID out of a Slack mention like
+// "<@U123|name>" or "<@U123>"; a bare ID is returned unchanged.
+func parseMentionUserID(raw string) string {
+	raw = strings.TrimPrefix(raw, "<")
+	raw = strings.TrimSuffix(raw, ">")
+	raw = strings.TrimPrefix(raw, "@")
+	if i := strings.Index(raw, "|"); i >= 0 {
+		raw = raw[:i]
+	}
+	return raw
+}
+
+func (c *NewBugReporter) assignBug(ctx context.Context, client cache.BugzillaClient, requester string, bugID int, bzEmail string) error {
+	if err := client.UpdateBug(bugID, bugzilla.BugUpdate{Status: "ASSIGNED", AssignedTo: bzEmail}); err != nil {
+		return c.replyEphemeral(ctx, requester, fmt.Sprintf("failed to assign #%d to %s: %v", bugID, bzEmail, err))
+	}
+	return c.replyEphemeral(ctx, requester, fmt.Sprintf("assigned #%d to %s", bugID, bzEmail))
+}
+
+func (c *NewBugReporter) replyEphemeral(ctx context.Context, userID, text string) error {
+	_, _, err := c.SlackClient(ctx).PostMessageChannel(
+		slackgo.MsgOptionPostEphemeral(userID),
+		slackgo.MsgOptionText(text, false),
+	)
+	return err
+}
+
+// handleMention reacts to "@shodan triage", posting a quick summary of the
+// bugs this reporter is currently watching.
+func (c *NewBugReporter) handleMention(ctx context.Context, event *slackevents.AppMentionEvent) error {
+	if !strings.Contains(strings.ToLower(event.Text), "triage") {
+		return nil
+	}
+
+	c.messagesLock.Lock()
+	watching := len(c.messagesToWatchAndUpdate)
+	c.messagesLock.Unlock()
+
+	return c.SlackClient(ctx).MessageChannel(fmt.Sprintf("currently watching %d new bug(s) for %s", watching, strings.Join(c.components, ", ")))
+}
+
+// defaultReactionTransitions is used whenever OperatorConfig.ReactionTransitions
+// is empty.
+var defaultReactionTransitions = map[string]string{
+	"eyes":             "ASSIGNED",
+	"white_check_mark": "POST",
+	"no_entry":         "NEEDINFO",
+}
+
+func (c *NewBugReporter) reactionTransitions() map[string]string {
+	if len(c.config.ReactionTransitions) > 0 {
+		return c.config.ReactionTransitions
+	}
+	return defaultReactionTransitions
+}
+
+// handleReaction moves a posted bug's status when someone reacts to its
+// card: :eyes: assigns it to the reactor, :white_check_mark: moves it to
+// POST, and :no_entry: flags it NEEDINFO with a threaded prompt asking for
+// detail.
+func (c *NewBugReporter) handleReaction(ctx context.Context, event *slackevents.ReactionAddedEvent) error {
+	status, ok := c.reactionTransitions()[event.Reaction]
+	if !ok {
+		return nil
+	}
+
+	c.messagesLock.Lock()
+	var m *message
+	for i := range c.messagesToWatchAndUpdate {
+		if c.messagesToWatchAndUpdate[i].channelID == event.Item.Channel && c.messagesToWatchAndUpdate[i].ts == event.Item.Timestamp {
+			m = &c.messagesToWatchAndUpdate[i]
+			break
+		}
+	}
+	c.messagesLock.Unlock()
+	if m == nil {
+		return nil // reaction on a message we didn't post
+	}
+
+	profile, err := c.slackGoClient.GetUserProfile(event.User, false)
+	if err != nil {
+		return fmt.Errorf("failed to get user profile of %v: %w", event.User, err)
+	}
+	bzEmail := slack.SlackEmailToBugzilla(&c.config, profile.Email)
+
+	update := bugzilla.BugUpdate{Status: status}
+	if status == "ASSIGNED" {
+		update.AssignedTo = bzEmail
+	}
+	if err := c.NewBugzillaClient(ctx).UpdateBug(m.ID, update); err != nil {
+		return fmt.Errorf("failed to move bug #%d to %s: %w", m.ID, status, err)
+	}
+
+	text := fmt.Sprintf("%s moved bug #%d to *%s*", bzEmail, m.ID, status)
+	if status == "NEEDINFO" {
+		text += " – please add the requested detail to the bug"
+	}
+	return c.postThreadedUpdate(ctx, *m, text)
+}
+
 func Report(ctx context.Context, client cache.BugzillaClient, components []string) (string, error) {
 	newBugs, err := getNewBugs(client, components, 0)
 	if err != nil {
@@ -277,6 +547,28 @@ func Report(ctx context.Context, client cache.BugzillaClient, components []strin
 	return strings.Join(lines, "\n"), nil
 }
 
+// reporterAdapter lets "new" be scheduled through the reporter registry
+// like any other report, components and all.
+type reporterAdapter struct {
+	components []string
+}
+
+func NewReporter(components []string) reporter.Reporter {
+	return &reporterAdapter{components: components}
+}
+
+func (a *reporterAdapter) Name() string { return "new" }
+
+func (a *reporterAdapter) Schedule() []string { return []string{"@every 1h"} }
+
+func (a *reporterAdapter) Sync(ctx context.Context, client cache.BugzillaClient, slackClient slack.ChannelClient) (reporter.Report, error) {
+	text, err := Report(ctx, client, a.components)
+	if err != nil || text == "" {
+		return reporter.Report{}, err
+	}
+	return reporter.Report{Component: strings.Join(a.components, ","), Text: text}, nil
+}
+
 func getNewBugs(client cache.BugzillaClient, components []string, lastID int) ([]*bugzilla.Bug, error) {
 	aq := bugzilla.AdvancedQuery{
 		Field: "bug_id",
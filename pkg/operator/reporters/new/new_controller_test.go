@@ -0,0 +1,47 @@
+package new
+
+import (
+	"testing"
+
+	"github.com/openshift-eng/shodan/pkg/operator/config"
+)
+
+func TestParseMentionUserID(t *testing.T) {
+	tests := []struct {
+		raw  string
+		want string
+	}{
+		{"<@U123|alice>", "U123"},
+		{"<@U123>", "U123"},
+		{"@U123", "U123"},
+		{"U123", "U123"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.raw, func(t *testing.T) {
+			if got := parseMentionUserID(tt.raw); got != tt.want {
+				t.Errorf("parseMentionUserID(%q) = %q, want %q", tt.raw, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReactionTransitions(t *testing.T) {
+	t.Run("falls back to defaultReactionTransitions when unconfigured", func(t *testing.T) {
+		c := &NewBugReporter{}
+		got := c.reactionTransitions()
+		if got["eyes"] != "ASSIGNED" || got["white_check_mark"] != "POST" || got["no_entry"] != "NEEDINFO" {
+			t.Errorf("got %v, want defaultReactionTransitions", got)
+		}
+	})
+
+	t.Run("uses the configured map when set", func(t *testing.T) {
+		c := &NewBugReporter{config: config.OperatorConfig{
+			ReactionTransitions: map[string]string{"thumbsup": "VERIFIED"},
+		}}
+		got := c.reactionTransitions()
+		if len(got) != 1 || got["thumbsup"] != "VERIFIED" {
+			t.Errorf("got %v, want only the configured override", got)
+		}
+	})
+}
@@ -0,0 +1,119 @@
+// Package reporters wires the operator's built-in reporters into the
+// pluggable reporter.Reporter registry and resolves the operator config's
+// reporters: section (including PluginDir/per-entry Plugin overrides) into
+// the set to actually run, so main only has to call these two functions
+// once at startup.
+package reporters
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	slackgo "github.com/slack-go/slack"
+
+	"github.com/openshift/library-go/pkg/operator/events"
+
+	"github.com/openshift-eng/shodan/pkg/cache"
+	"github.com/openshift-eng/shodan/pkg/operator/config"
+	"github.com/openshift-eng/shodan/pkg/operator/reporter"
+	"github.com/openshift-eng/shodan/pkg/operator/reporters/closed"
+	"github.com/openshift-eng/shodan/pkg/operator/reporters/leaderboard"
+	newreporter "github.com/openshift-eng/shodan/pkg/operator/reporters/new"
+	"github.com/openshift-eng/shodan/pkg/operator/state"
+	"github.com/openshift-eng/shodan/pkg/slack"
+)
+
+// registryKey returns the key a reporters: entry's reporter is registered
+// and looked up under. Entries that set Component are qualified by it, so
+// several entries can each name the same reporter (e.g. "new") with
+// different Component overrides without colliding under one shared
+// Name() in the registry.
+func registryKey(rc config.ReporterConfig) string {
+	if len(rc.Component) == 0 {
+		return rc.Name
+	}
+	return rc.Name + ":" + strings.Join(rc.Component, ",")
+}
+
+// namedReporter overrides the registry key a reporter is registered
+// under, by returning that key from Name(), without changing its
+// behavior otherwise.
+type namedReporter struct {
+	reporter.Reporter
+	name string
+}
+
+func (r *namedReporter) Name() string { return r.name }
+
+// RegisterBuiltins registers the in-tree reporters under their Name(), the
+// same way a *.so loaded via reporter.LoadPlugin registers itself.
+func RegisterBuiltins(cfg config.OperatorConfig, recorder events.Recorder, store *state.Store) {
+	reporter.Register(closed.NewReporter(cfg, recorder))
+	reporter.Register(leaderboard.NewReporter(store))
+	for _, rc := range cfg.Reporters {
+		if rc.Name == "new" {
+			reporter.Register(&namedReporter{Reporter: newreporter.NewReporter(rc.Component), name: registryKey(rc)})
+		}
+	}
+}
+
+// Configured loads cfg.PluginDir and any per-entry Plugin overrides, then
+// returns the reporters listed in cfg.Reporters, in the order configured.
+// api is used to build a per-reporter slack.ChannelClient for entries that
+// set Channel; it may be nil if no entry does.
+func Configured(cfg config.OperatorConfig, api *slackgo.Client) ([]reporter.Reporter, error) {
+	if cfg.PluginDir != "" {
+		if err := reporter.LoadPlugins(cfg.PluginDir); err != nil {
+			return nil, fmt.Errorf("loading plugins from %s: %w", cfg.PluginDir, err)
+		}
+	}
+
+	reporters := make([]reporter.Reporter, 0, len(cfg.Reporters))
+	for _, rc := range cfg.Reporters {
+		if rc.Plugin != "" {
+			path := rc.Plugin
+			if cfg.PluginDir != "" && !filepath.IsAbs(path) {
+				path = filepath.Join(cfg.PluginDir, path)
+			}
+			if err := reporter.LoadPlugin(path); err != nil {
+				return nil, err
+			}
+		}
+		r, ok := reporter.Get(registryKey(rc))
+		if !ok {
+			return nil, fmt.Errorf("reporter %q is configured but not registered", rc.Name)
+		}
+		if len(rc.Schedule) > 0 || rc.Channel != "" {
+			r = &configuredReporter{Reporter: r, schedule: rc.Schedule, channel: rc.Channel, api: api}
+		}
+		reporters = append(reporters, r)
+	}
+	return reporters, nil
+}
+
+// configuredReporter wraps a registered reporter with the per-entry
+// Schedule/Channel overrides from its ReporterConfig, so a reporters:
+// entry can reschedule or reroute a built-in or plugin reporter without
+// changing the reporter itself.
+type configuredReporter struct {
+	reporter.Reporter
+	schedule []string
+	channel  string
+	api      *slackgo.Client
+}
+
+func (r *configuredReporter) Schedule() []string {
+	if len(r.schedule) > 0 {
+		return r.schedule
+	}
+	return r.Reporter.Schedule()
+}
+
+func (r *configuredReporter) Sync(ctx context.Context, client cache.BugzillaClient, slackClient slack.ChannelClient) (reporter.Report, error) {
+	if r.channel != "" && r.api != nil {
+		slackClient = slack.NewClient(r.api, r.channel)
+	}
+	return r.Reporter.Sync(ctx, client, slackClient)
+}
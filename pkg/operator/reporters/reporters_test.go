@@ -0,0 +1,72 @@
+package reporters
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/openshift/library-go/pkg/operator/events/eventstesting"
+
+	"github.com/openshift-eng/shodan/pkg/operator/config"
+	"github.com/openshift-eng/shodan/pkg/operator/reporter"
+	"github.com/openshift-eng/shodan/pkg/operator/state"
+)
+
+func openTestStore(t *testing.T) *state.Store {
+	t.Helper()
+	s, err := state.Open(filepath.Join(t.TempDir(), "state.db"))
+	if err != nil {
+		t.Fatalf("Open: %v", err)
+	}
+	t.Cleanup(func() { s.Close() })
+	return s
+}
+
+// TestRegisterBuiltinsMultipleNewEntries covers a reporters: config with
+// two separate "new" entries overriding Component to different values
+// (e.g. one per team), which previously collided under the shared
+// registry key "new" and silently dropped the first entry's reporter.
+func TestRegisterBuiltinsMultipleNewEntries(t *testing.T) {
+	cfg := config.OperatorConfig{
+		Reporters: []config.ReporterConfig{
+			{Name: "new", Component: []string{"networking"}},
+			{Name: "new", Component: []string{"storage"}},
+		},
+	}
+	RegisterBuiltins(cfg, eventstesting.NewTestingEventRecorder(t), openTestStore(t))
+
+	networking, ok := reporter.Get(registryKey(cfg.Reporters[0]))
+	if !ok {
+		t.Fatalf("expected %q to be registered", registryKey(cfg.Reporters[0]))
+	}
+	storage, ok := reporter.Get(registryKey(cfg.Reporters[1]))
+	if !ok {
+		t.Fatalf("expected %q to be registered", registryKey(cfg.Reporters[1]))
+	}
+	if networking == storage {
+		t.Error("expected the networking and storage entries to register distinct reporters")
+	}
+}
+
+// TestConfiguredMultipleNewEntries covers resolving both entries back out
+// of Configured, in config order, rather than both resolving to whichever
+// entry registered last.
+func TestConfiguredMultipleNewEntries(t *testing.T) {
+	cfg := config.OperatorConfig{
+		Reporters: []config.ReporterConfig{
+			{Name: "new", Component: []string{"networking"}, Channel: "#networking-bugs"},
+			{Name: "new", Component: []string{"storage"}, Channel: "#storage-bugs"},
+		},
+	}
+	RegisterBuiltins(cfg, eventstesting.NewTestingEventRecorder(t), openTestStore(t))
+
+	reporters, err := Configured(cfg, nil)
+	if err != nil {
+		t.Fatalf("Configured: %v", err)
+	}
+	if len(reporters) != 2 {
+		t.Fatalf("got %d reporters, want 2", len(reporters))
+	}
+	if reporters[0] == reporters[1] {
+		t.Error("expected the two entries to resolve to distinct reporters")
+	}
+}
@@ -0,0 +1,227 @@
+// Package state persists small amounts of per-controller state in a local
+// BoltDB file, so things like the Slack messages a controller is watching
+// or a user's triage counters survive a restart.
+package state
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+// TakesBucket is the prefix for the per-week buckets holding per-user "bug
+// taken" counters, keyed by Bugzilla e-mail. Pair it with WeeklyBucket so
+// the leaderboard reporter ranks each week fresh instead of accumulating
+// forever, e.g. WeeklyBucket(TakesBucket, time.Now()).
+const TakesBucket = "takes"
+
+// AssignDurationsBucket is the prefix for the per-week buckets holding
+// each user's running NEW→ASSIGNED turnaround average (a JSON-encoded
+// DurationStat), keyed by Bugzilla e-mail. Pair it with WeeklyBucket the
+// same way as TakesBucket.
+const AssignDurationsBucket = "assign-durations"
+
+// WeekKey returns the ISO-8601 year-week containing t (e.g. "2026-W04"),
+// used to key buckets that should reset every week instead of
+// accumulating across the life of the store.
+func WeekKey(t time.Time) string {
+	year, week := t.ISOWeek()
+	return fmt.Sprintf("%d-W%02d", year, week)
+}
+
+// WeeklyBucket returns the bucket name for prefix during the week
+// containing t, e.g. WeeklyBucket(TakesBucket, time.Now()) == "takes/2026-W04".
+func WeeklyBucket(prefix string, t time.Time) string {
+	return prefix + "/" + WeekKey(t)
+}
+
+// DurationStat is a running average of durations recorded by RecordDuration.
+type DurationStat struct {
+	Count int           `json:"count"`
+	Total time.Duration `json:"total"`
+}
+
+// Average returns the mean of the recorded durations, or zero if none have
+// been recorded yet.
+func (d DurationStat) Average() time.Duration {
+	if d.Count == 0 {
+		return 0
+	}
+	return d.Total / time.Duration(d.Count)
+}
+
+// MessageRecord is the value stored, keyed by the Slack message ts, by
+// reporters that post cards and need to find them again after a restart.
+// new.NewBugReporter keeps these in one flat "messages-<components>"
+// bucket per reporter, not in nested messages/{channel} buckets; the
+// channel is carried in ChannelID instead.
+type MessageRecord struct {
+	BugID     int       `json:"bugID"`
+	ChannelID string    `json:"channelID"`
+	CreatedAt time.Time `json:"createdAt"`
+	Component string    `json:"component"`
+}
+
+// Bucket is a handle onto one BoltDB bucket (or nested bucket path).
+type Bucket interface {
+	Get(key string) ([]byte, bool)
+	Put(key string, value []byte) error
+	Delete(key string) error
+	ForEach(fn func(key string, value []byte) error) error
+}
+
+// Store wraps a BoltDB file with the bucket-per-concern layout used across
+// the operator's controllers.
+type Store struct {
+	db *bolt.DB
+}
+
+func Open(path string) (*Store, error) {
+	db, err := bolt.Open(path, 0600, &bolt.Options{Timeout: time.Second})
+	if err != nil {
+		return nil, err
+	}
+	return &Store{db: db}, nil
+}
+
+func (s *Store) Close() error {
+	return s.db.Close()
+}
+
+// GetBucket returns a handle onto the bucket at path, creating it (and any
+// parent buckets) if it doesn't exist yet. A multi-element path nests
+// buckets, e.g. GetBucket("messages", channelID) for the messages/{channel}
+// layout.
+func (s *Store) GetBucket(path ...string) (Bucket, error) {
+	if len(path) == 0 {
+		return nil, fmt.Errorf("state: bucket path must not be empty")
+	}
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		b, err := tx.CreateBucketIfNotExists([]byte(path[0]))
+		if err != nil {
+			return err
+		}
+		for _, p := range path[1:] {
+			if b, err = b.CreateBucketIfNotExists([]byte(p)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &boltBucket{db: s.db, path: path}, nil
+}
+
+// IncrementStat atomically increments the counter stored under key in
+// bucket and returns the new value, e.g. for TakesBucket/{user}.
+func (s *Store) IncrementStat(bucket, key string) (int, error) {
+	var count int
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		b, err := tx.CreateBucketIfNotExists([]byte(bucket))
+		if err != nil {
+			return err
+		}
+		if v := b.Get([]byte(key)); v != nil {
+			count, err = strconv.Atoi(string(v))
+			if err != nil {
+				return fmt.Errorf("corrupt counter %s/%s: %w", bucket, key, err)
+			}
+		}
+		count++
+		return b.Put([]byte(key), []byte(strconv.Itoa(count)))
+	})
+	return count, err
+}
+
+// RecordDuration folds d into the running average stored under key in
+// bucket (e.g. AssignDurationsBucket/{user}) and returns the updated stat.
+func (s *Store) RecordDuration(bucket, key string, d time.Duration) (DurationStat, error) {
+	var stat DurationStat
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		b, err := tx.CreateBucketIfNotExists([]byte(bucket))
+		if err != nil {
+			return err
+		}
+		if v := b.Get([]byte(key)); v != nil {
+			if err := json.Unmarshal(v, &stat); err != nil {
+				return fmt.Errorf("corrupt duration stat %s/%s: %w", bucket, key, err)
+			}
+		}
+		stat.Count++
+		stat.Total += d
+		value, err := json.Marshal(stat)
+		if err != nil {
+			return err
+		}
+		return b.Put([]byte(key), value)
+	})
+	return stat, err
+}
+
+type boltBucket struct {
+	db   *bolt.DB
+	path []string
+}
+
+func (b *boltBucket) open(tx *bolt.Tx) *bolt.Bucket {
+	bucket := tx.Bucket([]byte(b.path[0]))
+	for _, p := range b.path[1:] {
+		if bucket == nil {
+			return nil
+		}
+		bucket = bucket.Bucket([]byte(p))
+	}
+	return bucket
+}
+
+func (b *boltBucket) Get(key string) ([]byte, bool) {
+	var value []byte
+	_ = b.db.View(func(tx *bolt.Tx) error {
+		bucket := b.open(tx)
+		if bucket == nil {
+			return nil
+		}
+		if v := bucket.Get([]byte(key)); v != nil {
+			value = append([]byte(nil), v...)
+		}
+		return nil
+	})
+	return value, value != nil
+}
+
+func (b *boltBucket) Put(key string, value []byte) error {
+	return b.db.Update(func(tx *bolt.Tx) error {
+		bucket := b.open(tx)
+		if bucket == nil {
+			return fmt.Errorf("state: bucket %v does not exist", b.path)
+		}
+		return bucket.Put([]byte(key), value)
+	})
+}
+
+func (b *boltBucket) Delete(key string) error {
+	return b.db.Update(func(tx *bolt.Tx) error {
+		bucket := b.open(tx)
+		if bucket == nil {
+			return nil
+		}
+		return bucket.Delete([]byte(key))
+	})
+}
+
+func (b *boltBucket) ForEach(fn func(key string, value []byte) error) error {
+	return b.db.View(func(tx *bolt.Tx) error {
+		bucket := b.open(tx)
+		if bucket == nil {
+			return nil
+		}
+		return bucket.ForEach(func(k, v []byte) error {
+			return fn(string(k), append([]byte(nil), v...))
+		})
+	})
+}
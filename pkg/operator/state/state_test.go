@@ -0,0 +1,122 @@
+package state
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func openTestStore(t *testing.T) *Store {
+	t.Helper()
+	s, err := Open(filepath.Join(t.TempDir(), "state.db"))
+	if err != nil {
+		t.Fatalf("Open: %v", err)
+	}
+	t.Cleanup(func() { s.Close() })
+	return s
+}
+
+func TestIncrementStat(t *testing.T) {
+	s := openTestStore(t)
+
+	for i, want := range []int{1, 2, 3} {
+		got, err := s.IncrementStat(TakesBucket, "alice@example.com")
+		if err != nil {
+			t.Fatalf("IncrementStat call %d: %v", i, err)
+		}
+		if got != want {
+			t.Errorf("IncrementStat call %d = %d, want %d", i, got, want)
+		}
+	}
+
+	// A different key starts its own counter at 1.
+	got, err := s.IncrementStat(TakesBucket, "bob@example.com")
+	if err != nil {
+		t.Fatalf("IncrementStat: %v", err)
+	}
+	if got != 1 {
+		t.Errorf("got %d, want 1", got)
+	}
+}
+
+func TestRecordDuration(t *testing.T) {
+	s := openTestStore(t)
+
+	stat, err := s.RecordDuration(AssignDurationsBucket, "alice@example.com", time.Hour)
+	if err != nil {
+		t.Fatalf("RecordDuration: %v", err)
+	}
+	if stat.Average() != time.Hour {
+		t.Errorf("got average %v, want %v", stat.Average(), time.Hour)
+	}
+
+	stat, err = s.RecordDuration(AssignDurationsBucket, "alice@example.com", 3*time.Hour)
+	if err != nil {
+		t.Fatalf("RecordDuration: %v", err)
+	}
+	if want := 2 * time.Hour; stat.Average() != want {
+		t.Errorf("got average %v, want %v", stat.Average(), want)
+	}
+}
+
+func TestGetBucketNesting(t *testing.T) {
+	s := openTestStore(t)
+
+	bucket, err := s.GetBucket("messages", "C123")
+	if err != nil {
+		t.Fatalf("GetBucket: %v", err)
+	}
+	if err := bucket.Put("ts-1", []byte("record")); err != nil {
+		t.Fatalf("Put: %v", err)
+	}
+
+	// Re-opening the same nested path returns a handle onto the same data.
+	reopened, err := s.GetBucket("messages", "C123")
+	if err != nil {
+		t.Fatalf("GetBucket: %v", err)
+	}
+	value, ok := reopened.Get("ts-1")
+	if !ok || string(value) != "record" {
+		t.Errorf("got (%q, %v), want (\"record\", true)", value, ok)
+	}
+
+	// A sibling nested bucket doesn't see the other's keys.
+	sibling, err := s.GetBucket("messages", "C456")
+	if err != nil {
+		t.Fatalf("GetBucket: %v", err)
+	}
+	if _, ok := sibling.Get("ts-1"); ok {
+		t.Error("expected sibling bucket to not see C123's keys")
+	}
+}
+
+func TestBucketDelete(t *testing.T) {
+	s := openTestStore(t)
+
+	bucket, err := s.GetBucket("messages")
+	if err != nil {
+		t.Fatalf("GetBucket: %v", err)
+	}
+	if err := bucket.Put("ts-1", []byte("record")); err != nil {
+		t.Fatalf("Put: %v", err)
+	}
+	if err := bucket.Delete("ts-1"); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	if _, ok := bucket.Get("ts-1"); ok {
+		t.Error("expected key to be gone after Delete")
+	}
+}
+
+func TestWeeklyBucket(t *testing.T) {
+	sameWeek := time.Date(2026, 1, 19, 9, 0, 0, 0, time.UTC)     // Monday
+	alsoSameWeek := time.Date(2026, 1, 25, 0, 0, 0, 0, time.UTC) // Sunday, same ISO week
+	nextWeek := time.Date(2026, 1, 26, 0, 0, 0, 0, time.UTC)     // Monday, next ISO week
+
+	if got, want := WeeklyBucket(TakesBucket, sameWeek), WeeklyBucket(TakesBucket, alsoSameWeek); got != want {
+		t.Errorf("days in the same ISO week got different buckets: %q vs %q", got, want)
+	}
+	if got, other := WeeklyBucket(TakesBucket, sameWeek), WeeklyBucket(TakesBucket, nextWeek); got == other {
+		t.Errorf("days in different ISO weeks got the same bucket: %q", got)
+	}
+}
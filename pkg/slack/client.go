@@ -0,0 +1,51 @@
+// Package slack wraps the slack-go client with the narrow interface the
+// operator's reporters and controllers actually need.
+package slack
+
+import (
+	slackgo "github.com/slack-go/slack"
+
+	"github.com/openshift-eng/shodan/pkg/operator/config"
+)
+
+// ChannelClient is the interface reporters use to post into their
+// configured Slack channel.
+type ChannelClient interface {
+	// MessageChannel posts a plain markdown message to the channel.
+	MessageChannel(text string) error
+
+	// PostMessageChannel posts a message built from Slack message options
+	// (blocks, attachments, ...) and returns the channel and timestamp of
+	// the posted message, so callers can later update it or thread off it.
+	PostMessageChannel(options ...slackgo.MsgOption) (channelID, ts string, err error)
+}
+
+// Client is the default ChannelClient implementation, bound to a single
+// channel.
+type Client struct {
+	api     *slackgo.Client
+	channel string
+}
+
+func NewClient(api *slackgo.Client, channel string) *Client {
+	return &Client{api: api, channel: channel}
+}
+
+func (c *Client) MessageChannel(text string) error {
+	_, _, err := c.api.PostMessage(c.channel, slackgo.MsgOptionText(text, false))
+	return err
+}
+
+func (c *Client) PostMessageChannel(options ...slackgo.MsgOption) (string, string, error) {
+	return c.api.PostMessage(c.channel, options...)
+}
+
+// SlackEmailToBugzilla maps a Slack user's e-mail address to the address
+// they use in Bugzilla, for users configured in OperatorConfig.UserMapping
+// because the two differ.
+func SlackEmailToBugzilla(cfg *config.OperatorConfig, email string) string {
+	if mapped, ok := cfg.UserMapping[email]; ok {
+		return mapped
+	}
+	return email
+}
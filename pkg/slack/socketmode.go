@@ -0,0 +1,169 @@
+package slack
+
+import (
+	"context"
+
+	slackgo "github.com/slack-go/slack"
+	"github.com/slack-go/slack/slackevents"
+	"github.com/slack-go/slack/socketmode"
+	"k8s.io/klog"
+)
+
+// SlashCommandHandler handles a slash command invocation (e.g. "/bug take 1234").
+type SlashCommandHandler func(ctx context.Context, cmd slackgo.SlashCommand) error
+
+// MentionHandler handles an @mention of the bot in a channel message.
+type MentionHandler func(ctx context.Context, event *slackevents.AppMentionEvent) error
+
+// AppHomeOpenedHandler handles a user opening the bot's Home tab.
+type AppHomeOpenedHandler func(ctx context.Context, event *slackevents.AppHomeOpenedEvent) error
+
+// ReactionHandler handles a reaction_added event. It is invoked for every
+// reaction the bot can see, not only for reactions on messages it posted.
+type ReactionHandler func(ctx context.Context, event *slackevents.ReactionAddedEvent) error
+
+// EventLoop drives a Socket Mode connection and dispatches incoming events
+// to registered handlers, analogous to how the old RTM-based
+// watchRTMEventChannel drove interactions off a single long-lived
+// connection. Unlike the RTM loop, every event is acknowledged
+// deterministically via socketmode.Ack instead of a fixed sleep.
+type EventLoop struct {
+	client *socketmode.Client
+
+	blockActions  map[string]BlockActionHandler
+	slashCommands map[string]SlashCommandHandler
+	mentions      []MentionHandler
+	appHomeOpened []AppHomeOpenedHandler
+	reactions     []ReactionHandler
+}
+
+func NewEventLoop(api *slackgo.Client) *EventLoop {
+	return &EventLoop{
+		client:        socketmode.New(api),
+		blockActions:  map[string]BlockActionHandler{},
+		slashCommands: map[string]SlashCommandHandler{},
+	}
+}
+
+// BlockActionHandler handles a Slack interactive block_action. It mirrors
+// controller.BlockActionHandler so the event loop doesn't need to import
+// the controller package.
+type BlockActionHandler func(ctx context.Context, message *slackgo.Container, user *slackgo.User, action *slackgo.BlockAction)
+
+func (e *EventLoop) SubscribeBlockAction(id string, handler BlockActionHandler) error {
+	e.blockActions[id] = handler
+	return nil
+}
+
+func (e *EventLoop) SubscribeSlashCommand(command string, handler SlashCommandHandler) error {
+	e.slashCommands[command] = handler
+	return nil
+}
+
+func (e *EventLoop) SubscribeMention(handler MentionHandler) error {
+	e.mentions = append(e.mentions, handler)
+	return nil
+}
+
+func (e *EventLoop) SubscribeAppHomeOpened(handler AppHomeOpenedHandler) error {
+	e.appHomeOpened = append(e.appHomeOpened, handler)
+	return nil
+}
+
+func (e *EventLoop) SubscribeReaction(handler ReactionHandler) error {
+	e.reactions = append(e.reactions, handler)
+	return nil
+}
+
+// Run connects in Socket Mode and dispatches events until ctx is
+// cancelled.
+func (e *EventLoop) Run(ctx context.Context) error {
+	go e.client.RunContext(ctx)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case evt := <-e.client.Events:
+			e.dispatch(ctx, evt)
+		}
+	}
+}
+
+func (e *EventLoop) dispatch(ctx context.Context, evt socketmode.Event) {
+	switch evt.Type {
+	case socketmode.EventTypeInteractive:
+		callback, ok := evt.Data.(slackgo.InteractionCallback)
+		if !ok {
+			return
+		}
+		if evt.Request != nil {
+			e.client.Ack(*evt.Request)
+		}
+		for _, action := range callback.ActionCallback.BlockActions {
+			if handler, ok := e.blockActions[action.BlockID]; ok {
+				handler(ctx, &callback.Container, &callback.User, action)
+			}
+		}
+
+	case socketmode.EventTypeSlashCommand:
+		cmd, ok := evt.Data.(slackgo.SlashCommand)
+		if !ok {
+			return
+		}
+		if evt.Request != nil {
+			e.client.Ack(*evt.Request)
+		}
+		handler, ok := e.slashCommands[cmd.Command]
+		if !ok {
+			klog.Warningf("no handler registered for slash command %q", cmd.Command)
+			return
+		}
+		// Slash commands hit Bugzilla/Slack, same as takeClicked; run them
+		// off the dispatch loop so a slow one doesn't stall every other
+		// event.
+		go func() {
+			if err := handler(ctx, cmd); err != nil {
+				klog.Errorf("slash command %q failed: %v", cmd.Command, err)
+			}
+		}()
+
+	case socketmode.EventTypeEventsAPI:
+		apiEvent, ok := evt.Data.(slackevents.EventsAPIEvent)
+		if !ok {
+			return
+		}
+		if evt.Request != nil {
+			e.client.Ack(*evt.Request)
+		}
+		switch inner := apiEvent.InnerEvent.Data.(type) {
+		case *slackevents.AppMentionEvent:
+			for _, handler := range e.mentions {
+				handler := handler
+				go func() {
+					if err := handler(ctx, inner); err != nil {
+						klog.Errorf("mention handler failed: %v", err)
+					}
+				}()
+			}
+		case *slackevents.AppHomeOpenedEvent:
+			for _, handler := range e.appHomeOpened {
+				handler := handler
+				go func() {
+					if err := handler(ctx, inner); err != nil {
+						klog.Errorf("app_home_opened handler failed: %v", err)
+					}
+				}()
+			}
+		case *slackevents.ReactionAddedEvent:
+			for _, handler := range e.reactions {
+				handler := handler
+				go func() {
+					if err := handler(ctx, inner); err != nil {
+						klog.Errorf("reaction_added handler failed: %v", err)
+					}
+				}()
+			}
+		}
+	}
+}